@@ -0,0 +1,160 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceClusterIamRoleAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClusterIamRoleAttachmentCreate,
+		Read:   resourceClusterIamRoleAttachmentRead,
+		Delete: resourceClusterIamRoleAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceClusterIamRoleAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	clusterID := d.Get("cluster_identifier").(string)
+	roleARN := d.Get("iam_role_arn").(string)
+
+	input := &redshift.ModifyClusterIamRolesInput{
+		ClusterIdentifier: aws.String(clusterID),
+		AddIamRoles:       aws.StringSlice([]string{roleARN}),
+	}
+
+	log.Printf("[DEBUG] Attaching Redshift Cluster IAM Role: %s", input)
+	_, err := conn.ModifyClusterIamRoles(input)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Redshift Cluster (%s) IAM role (%s): %w", clusterID, roleARN, err)
+	}
+
+	d.SetId(resourceClusterIamRoleAttachmentCreateID(clusterID, roleARN))
+
+	if _, err := waitClusterUpdated(conn, clusterID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Redshift Cluster IAM Role Attachment (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceClusterIamRoleAttachmentRead(d, meta)
+}
+
+func resourceClusterIamRoleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	clusterID, roleARN, err := resourceClusterIamRoleAttachmentParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	rsc, err := FindClusterByID(conn, clusterID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Cluster (%s) not found, removing Cluster IAM Role Attachment (%s) from state", clusterID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Cluster IAM Role Attachment (%s): %w", d.Id(), err)
+	}
+
+	var attached bool
+	for _, iamRole := range rsc.IamRoles {
+		if aws.StringValue(iamRole.IamRoleArn) == roleARN {
+			attached = true
+			break
+		}
+	}
+
+	if !d.IsNewResource() && !attached {
+		log.Printf("[WARN] Redshift Cluster (%s) IAM Role (%s) not attached, removing from state", clusterID, roleARN)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_identifier", clusterID)
+	d.Set("iam_role_arn", roleARN)
+
+	return nil
+}
+
+func resourceClusterIamRoleAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	clusterID, roleARN, err := resourceClusterIamRoleAttachmentParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &redshift.ModifyClusterIamRolesInput{
+		ClusterIdentifier: aws.String(clusterID),
+		RemoveIamRoles:    aws.StringSlice([]string{roleARN}),
+	}
+
+	log.Printf("[DEBUG] Detaching Redshift Cluster IAM Role: %s", input)
+	_, err = conn.ModifyClusterIamRoles(input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error detaching Redshift Cluster (%s) IAM role (%s): %w", clusterID, roleARN, err)
+	}
+
+	if _, err := waitClusterUpdated(conn, clusterID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Redshift Cluster IAM Role Attachment (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceClusterIamRoleAttachmentCreateID(clusterID, roleARN string) string {
+	return fmt.Sprintf("%s,%s", clusterID, roleARN)
+}
+
+func resourceClusterIamRoleAttachmentParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%q), expected cluster_identifier,iam_role_arn", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -1,11 +1,15 @@
 package redshift
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -14,6 +18,35 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// iamRoleNameRegexp matches a bare IAM role name, as opposed to a fully
+// qualified role ARN. It mirrors the IAM role name constraints so that
+// "iam_roles" and "default_iam_role_arn" can accept either form.
+var iamRoleNameRegexp = regexp.MustCompile(`^[\w+=,.@-]{1,64}$`)
+
+func validateIAMRoleNameOrARN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if strings.HasPrefix(value, "arn:") {
+		return verify.ValidARN(v, k)
+	}
+
+	if !iamRoleNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a valid IAM role ARN or role name, got: %s", k, value))
+	}
+
+	return ws, errors
+}
+
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := time.ParseDuration(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid duration: %w", k, err))
+	}
+
+	return ws, errors
+}
+
 func ResourceClusterIamRoles() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClusterIamRolesCreate,
@@ -24,6 +57,8 @@ func ResourceClusterIamRoles() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceClusterIamRolesCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(75 * time.Minute),
 			Update: schema.DefaultTimeout(75 * time.Minute),
@@ -39,7 +74,7 @@ func ResourceClusterIamRoles() *schema.Resource {
 			"default_iam_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: validateIAMRoleNameOrARN,
 			},
 			"iam_roles": {
 				Type:     schema.TypeSet,
@@ -47,26 +82,127 @@ func ResourceClusterIamRoles() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: validateIAMRoleNameOrARN,
 				},
 			},
+			"skip_final_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"polling_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
+			"cluster_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// resourceClusterIamRolesCustomizeDiff resolves any bare IAM role names in
+// "iam_roles"/"default_iam_role_arn" to their ARNs at plan time, so that the
+// planned value compares equal to the ARNs Read writes back into state.
+// Without this, config holding a name and state holding the equivalent ARN
+// would never converge.
+func resourceClusterIamRolesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	iamconn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("iam_roles") {
+		if v := d.Get("iam_roles").(*schema.Set); v.Len() > 0 {
+			roleARNs, err := resolveIAMRoleARNs(iamconn, flex.ExpandStringSet(v))
+
+			if err != nil {
+				return err
+			}
+
+			if err := d.SetNew("iam_roles", flex.FlattenStringSet(roleARNs)); err != nil {
+				return err
+			}
+		}
+	}
+
+	roleARNs := flex.ExpandStringSet(d.Get("iam_roles").(*schema.Set))
+
+	if d.HasChange("default_iam_role_arn") {
+		if v := d.Get("default_iam_role_arn").(string); v != "" {
+			defaultRoleARN, err := resolveIAMRoleARN(iamconn, v)
+
+			if err != nil {
+				return err
+			}
+
+			if !stringSliceContainsARN(roleARNs, defaultRoleARN) {
+				return fmt.Errorf("default_iam_role_arn (%s) must also be present in iam_roles", defaultRoleARN)
+			}
+
+			if err := d.SetNew("default_iam_role_arn", defaultRoleARN); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// waitClusterUpdatedAfterIamRoles waits for the cluster update triggered by
+// ModifyClusterIamRoles to settle, polling at the "polling_interval" cadence
+// when one is set. It returns immediately when "skip_final_wait" is true.
+func waitClusterUpdatedAfterIamRoles(d *schema.ResourceData, conn *redshift.Redshift, id string, timeout time.Duration) error {
+	if d.Get("skip_final_wait").(bool) {
+		return nil
+	}
+
+	if v, ok := d.GetOk("polling_interval"); ok {
+		pollInterval, err := time.ParseDuration(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("error parsing polling_interval: %w", err)
+		}
+
+		if _, err := waitClusterUpdated(conn, id, timeout, withPollInterval(pollInterval)); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if _, err := waitClusterUpdated(conn, id, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func resourceClusterIamRolesCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RedshiftConn
+	iamconn := meta.(*conns.AWSClient).IAMConn
 
 	input := &redshift.ModifyClusterIamRolesInput{
 		ClusterIdentifier: aws.String(d.Get("cluster_identifier").(string)),
 	}
 
 	if v, ok := d.GetOk("iam_roles"); ok && v.(*schema.Set).Len() > 0 {
-		input.AddIamRoles = flex.ExpandStringSet(v.(*schema.Set))
+		roleARNs, err := resolveIAMRoleARNs(iamconn, flex.ExpandStringSet(v.(*schema.Set)))
+
+		if err != nil {
+			return err
+		}
+
+		input.AddIamRoles = roleARNs
 	}
 
 	if v, ok := d.GetOk("default_iam_role_arn"); ok {
-		input.DefaultIamRoleArn = aws.String(v.(string))
+		defaultRoleARN, err := resolveIAMRoleARN(iamconn, v.(string))
+
+		if err != nil {
+			return err
+		}
+
+		input.DefaultIamRoleArn = aws.String(defaultRoleARN)
 	}
 
 	log.Printf("[DEBUG] Adding Redshift Cluster Iam Roles IAM Roles: %s", input)
@@ -78,7 +214,7 @@ func resourceClusterIamRolesCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(aws.StringValue(out.Cluster.ClusterIdentifier))
 
-	if _, err := waitClusterUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := waitClusterUpdatedAfterIamRoles(d, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("error waiting for Redshift Cluster Iam Roles (%s) update: %w", d.Id(), err)
 	}
 
@@ -108,12 +244,14 @@ func resourceClusterIamRolesRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("iam_roles", aws.StringValueSlice(apiList))
 	d.Set("default_iam_role_arn", rsc.DefaultIamRoleArn)
 	d.Set("cluster_identifier", rsc.ClusterIdentifier)
+	d.Set("cluster_status", rsc.ClusterStatus)
 
 	return nil
 }
 
 func resourceClusterIamRolesUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RedshiftConn
+	iamconn := meta.(*conns.AWSClient).IAMConn
 
 	o, n := d.GetChange("iam_roles")
 	if o == nil {
@@ -125,24 +263,60 @@ func resourceClusterIamRolesUpdate(d *schema.ResourceData, meta interface{}) err
 
 	os := o.(*schema.Set)
 	ns := n.(*schema.Set)
-	add := ns.Difference(os)
-	del := os.Difference(ns)
+
+	// Resolve both sides of the diff to ARNs before computing add/remove, so
+	// that a role referenced by name on one side and by ARN on the other
+	// (e.g. config holds a name, prior state holds the API's ARN) doesn't
+	// look like a remove-and-re-add of every role.
+	oldARNs, err := resolveIAMRoleARNs(iamconn, flex.ExpandStringSet(os))
+
+	if err != nil {
+		return err
+	}
+
+	newARNs, err := resolveIAMRoleARNs(iamconn, flex.ExpandStringSet(ns))
+
+	if err != nil {
+		return err
+	}
+
+	oldSet := flex.FlattenStringSet(oldARNs)
+	newSet := flex.FlattenStringSet(newARNs)
+	add := newSet.Difference(oldSet)
+	del := oldSet.Difference(newSet)
 
 	input := &redshift.ModifyClusterIamRolesInput{
 		AddIamRoles:       flex.ExpandStringSet(add),
 		ClusterIdentifier: aws.String(d.Id()),
 		RemoveIamRoles:    flex.ExpandStringSet(del),
-		DefaultIamRoleArn: aws.String(d.Get("default_iam_role_arn").(string)),
+	}
+
+	if d.HasChange("default_iam_role_arn") {
+		newDefault := d.Get("default_iam_role_arn").(string)
+
+		if newDefault != "" {
+			defaultRoleARN, err := resolveIAMRoleARN(iamconn, newDefault)
+
+			if err != nil {
+				return err
+			}
+
+			input.DefaultIamRoleArn = aws.String(defaultRoleARN)
+		} else {
+			// The Redshift API accepts an explicit empty string to drop the
+			// cluster's default IAM role.
+			input.DefaultIamRoleArn = aws.String("")
+		}
 	}
 
 	log.Printf("[DEBUG] Modifying Redshift Cluster Iam Roles IAM Roles: %s", input)
-	_, err := conn.ModifyClusterIamRoles(input)
+	_, err = conn.ModifyClusterIamRoles(input)
 
 	if err != nil {
 		return fmt.Errorf("error modifying Redshift Cluster Iam Roles (%s) IAM roles: %w", d.Id(), err)
 	}
 
-	if _, err := waitClusterUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := waitClusterUpdatedAfterIamRoles(d, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("error waiting for Redshift Cluster Iam Roles (%s) update: %w", d.Id(), err)
 	}
 
@@ -155,7 +329,7 @@ func resourceClusterIamRolesDelete(d *schema.ResourceData, meta interface{}) err
 	input := &redshift.ModifyClusterIamRolesInput{
 		ClusterIdentifier: aws.String(d.Id()),
 		RemoveIamRoles:    flex.ExpandStringSet(d.Get("iam_roles").(*schema.Set)),
-		DefaultIamRoleArn: aws.String(d.Get("default_iam_role_arn").(string)),
+		DefaultIamRoleArn: aws.String(""),
 	}
 
 	log.Printf("[DEBUG] Removing Redshift Cluster Iam Roles IAM Roles: %s", input)
@@ -165,9 +339,53 @@ func resourceClusterIamRolesDelete(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error removing Redshift Cluster Iam Roles (%s) IAM roles: %w", d.Id(), err)
 	}
 
-	if _, err := waitClusterUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := waitClusterUpdatedAfterIamRoles(d, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for Redshift Cluster Iam Roles (%s) removal: %w", d.Id(), err)
 	}
 
 	return nil
 }
+
+// resolveIAMRoleARN accepts either a bare IAM role name or a role ARN and
+// always returns the ARN, resolving names via the IAM API.
+func resolveIAMRoleARN(conn *iam.IAM, v string) (string, error) {
+	if strings.HasPrefix(v, "arn:") {
+		return v, nil
+	}
+
+	output, err := conn.GetRole(&iam.GetRoleInput{
+		RoleName: aws.String(v),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("error resolving IAM role name (%s) to an ARN: %w", v, err)
+	}
+
+	return aws.StringValue(output.Role.Arn), nil
+}
+
+func resolveIAMRoleARNs(conn *iam.IAM, vs []*string) ([]*string, error) {
+	arns := make([]*string, 0, len(vs))
+
+	for _, v := range vs {
+		arn, err := resolveIAMRoleARN(conn, aws.StringValue(v))
+
+		if err != nil {
+			return nil, err
+		}
+
+		arns = append(arns, aws.String(arn))
+	}
+
+	return arns, nil
+}
+
+func stringSliceContainsARN(arns []*string, arn string) bool {
+	for _, v := range arns {
+		if aws.StringValue(v) == arn {
+			return true
+		}
+	}
+
+	return false
+}
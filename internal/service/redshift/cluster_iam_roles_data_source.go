@@ -0,0 +1,70 @@
+package redshift
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceClusterIamRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClusterIamRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"default_iam_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iam_roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"apply_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClusterIamRolesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	clusterID := d.Get("cluster_identifier").(string)
+
+	rsc, err := FindClusterByID(conn, clusterID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Cluster IAM Roles (%s): %w", clusterID, err)
+	}
+
+	iamRoles := make([]map[string]interface{}, 0, len(rsc.IamRoles))
+
+	for _, iamRole := range rsc.IamRoles {
+		iamRoles = append(iamRoles, map[string]interface{}{
+			"iam_role_arn": aws.StringValue(iamRole.IamRoleArn),
+			"apply_status": aws.StringValue(iamRole.ApplyStatus),
+		})
+	}
+
+	d.SetId(clusterID)
+	d.Set("cluster_identifier", rsc.ClusterIdentifier)
+	d.Set("default_iam_role_arn", rsc.DefaultIamRoleArn)
+	d.Set("iam_roles", iamRoles)
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package redshift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRedshiftClusterIamRolesDataSource_none(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_redshift_cluster_iam_roles.test"
+	resourceName := "aws_redshift_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterIamRolesDataSourceConfig_none(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_identifier", resourceName, "cluster_identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "iam_roles.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_iam_role_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftClusterIamRolesDataSource_single(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_redshift_cluster_iam_roles.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterIamRolesDataSourceConfig_single(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "iam_roles.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_roles.0.iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "iam_roles.0.apply_status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftClusterIamRolesDataSource_multiple(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_redshift_cluster_iam_roles.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterIamRolesDataSourceConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "iam_roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterIamRolesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier       = %[1]q
+  database_name            = "mydb"
+  master_username           = "foo_test"
+  master_password           = "Mustbe8characters"
+  node_type                 = "dc2.large"
+  cluster_type              = "single-node"
+  skip_final_snapshot       = true
+}
+`, rName)
+}
+
+func testAccClusterIamRolesDataSourceConfig_none(rName string) string {
+	return acctest.ConfigCompose(testAccClusterIamRolesDataSourceConfig_base(rName), `
+data "aws_redshift_cluster_iam_roles" "test" {
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+}
+`)
+}
+
+func testAccClusterIamRolesDataSourceConfig_single(rName string) string {
+	return acctest.ConfigCompose(testAccClusterIamRolesDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.redshift_assume_role.json
+}
+
+data "aws_iam_policy_document" "redshift_assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["redshift.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_redshift_cluster_iam_roles" "test" {
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+  iam_roles          = [aws_iam_role.test.arn]
+}
+
+data "aws_redshift_cluster_iam_roles" "test" {
+  cluster_identifier = aws_redshift_cluster_iam_roles.test.cluster_identifier
+}
+`, rName))
+}
+
+func testAccClusterIamRolesDataSourceConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccClusterIamRolesDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  count              = 2
+  name               = "%[1]s-${count.index}"
+  assume_role_policy = data.aws_iam_policy_document.redshift_assume_role.json
+}
+
+data "aws_iam_policy_document" "redshift_assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["redshift.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_redshift_cluster_iam_roles" "test" {
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+  iam_roles          = aws_iam_role.test[*].arn
+}
+
+data "aws_redshift_cluster_iam_roles" "test" {
+  cluster_identifier = aws_redshift_cluster_iam_roles.test.cluster_identifier
+}
+`, rName))
+}
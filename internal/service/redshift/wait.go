@@ -0,0 +1,42 @@
+package redshift
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// waitClusterUpdated waits for a cluster modification (such as resizing or
+// an IAM role attach/detach) to settle back to "available". Callers that
+// need a tighter poll cadence than the default can pass withPollInterval.
+func waitClusterUpdated(conn *redshift.Redshift, id string, timeout time.Duration, optFns ...func(*resource.StateChangeConf)) (*redshift.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"modifying", "resizing"},
+		Target:     []string{"available"},
+		Refresh:    statusCluster(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	for _, optFn := range optFns {
+		optFn(stateConf)
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*redshift.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// withPollInterval overrides the StateChangeConf poll cadence used by
+// waitClusterUpdated, for callers that want tighter control over how often
+// DescribeClusters is polled.
+func withPollInterval(interval time.Duration) func(*resource.StateChangeConf) {
+	return func(conf *resource.StateChangeConf) {
+		conf.PollInterval = interval
+	}
+}